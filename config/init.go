@@ -9,5 +9,14 @@ func init() {
 	// environment - could be "local", "prod", "dev"
 	viper.SetDefault("env", "prod")
 
+	// uuid version for generated identifiers - could be 4 or 7
+	viper.SetDefault("id.version", 4)
+
+	// PII redaction in logs, emails, bearer tokens and JWTs are always masked
+	viper.SetDefault("log.redact.enabled", true)
+	viper.SetDefault("log.redact.patterns", []string{})
+	viper.SetDefault("log.redact.fields", []string{"password", "token", "secret", "authorization"})
+	viper.SetDefault("log.redact.allowlist", []string{"local"})
+
 	// TODO add default values for all configuration fields
 }
@@ -4,5 +4,35 @@ package config
 type Scheme struct {
 	// Env is the application environment.
 	Env string
+	// ID is the identifiers generation configuration.
+	ID IDConfig
+	// Log is the logging configuration.
+	Log LogConfig
 	// TODO add needed config params
 }
+
+// IDConfig represents the identifiers generation configuration.
+type IDConfig struct {
+	// Version is the UUID version to generate, could be 4 or 7.
+	Version int
+}
+
+// LogConfig represents the logging configuration.
+type LogConfig struct {
+	// Redact is the PII redaction configuration.
+	Redact RedactConfig
+}
+
+// RedactConfig represents the PII redaction configuration.
+type RedactConfig struct {
+	// Enabled toggles masking of PII in log messages and fields.
+	Enabled bool
+	// Patterns is a list of additional regular expressions to mask.
+	Patterns []string
+	// Fields is a list of names, values of log fields which keys contain
+	// any of them as whole "_", "-", "." or camelCase separated segments
+	// (case-insensitive) are always masked.
+	Fields []string
+	// Allowlist is a list of environments where full values are logged.
+	Allowlist []string
+}
@@ -4,11 +4,16 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"slices"
 	"syscall"
 
+	"github.com/misnaged/annales/logger"
 	version "github.com/misnaged/annales/versioner"
 
 	"microservice-template/config"
+	"microservice-template/pkg/clock"
+	"microservice-template/pkg/idgen"
+	"microservice-template/pkg/redact"
 )
 
 // App is main microservice application instance that
@@ -19,6 +24,13 @@ type App struct {
 
 	version *version.Version
 
+	// time source that should be injected into all time dependent instances
+	clock clock.Clock
+
+	// identifiers generator that should be injected into all instances creating IDs,
+	// defaults to UUIDv4 and is replaced in Init according to configured version
+	ids idgen.Generator
+
 	// TODO add all needed dependencies
 }
 
@@ -32,16 +44,46 @@ func NewApplication() (app *App, err error) {
 	return &App{
 		config:  &config.Scheme{},
 		version: ver,
+		clock:   clock.New(),
+		ids:     idgen.NewV4(),
 	}, nil
 }
 
 // Init initialize application and all necessary instances
 func (app *App) Init() error {
+	if err := app.initLogRedaction(); err != nil {
+		return fmt.Errorf("init log redaction: %w", err)
+	}
+
+	ids, err := idgen.New(app.config.ID.Version)
+	if err != nil {
+		return fmt.Errorf("init id generator: %w", err)
+	}
+	app.ids = ids
+
 	// TODO add dependencies initialisations
 
 	return nil
 }
 
+// initLogRedaction register PII redaction hook on application
+// logger unless it is disabled or current environment is allowlisted
+func (app *App) initLogRedaction() error {
+	cfg := app.config.Log.Redact
+	if !cfg.Enabled || slices.Contains(cfg.Allowlist, app.config.Env) {
+		return nil
+	}
+
+	hook, err := redact.NewHook(cfg.Patterns, cfg.Fields)
+	if err != nil {
+		return err
+	}
+
+	logger.Log().AddHook(hook)
+
+	return nil
+}
+
 // Serve start serving Application service
 func (app *App) Serve() error {
 	// TODO add all runners that needed in separate goroutines
@@ -67,6 +109,16 @@ func (app *App) Config() *config.Scheme {
 	return app.config
 }
 
+// Clock return application time source
+func (app *App) Clock() clock.Clock {
+	return app.clock
+}
+
+// IDs return application identifiers generator
+func (app *App) IDs() idgen.Generator {
+	return app.ids
+}
+
 // Version return application current version
 func (app *App) Version() string {
 	return app.version.String()
@@ -0,0 +1,61 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/misnaged/annales/logger"
+	"github.com/sirupsen/logrus"
+
+	"microservice-template/config"
+)
+
+func TestInitLogRedaction(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      config.Scheme
+		wantHook bool
+	}{
+		{
+			name: "enabled",
+			cfg: config.Scheme{
+				Env: "prod",
+				Log: config.LogConfig{Redact: config.RedactConfig{Enabled: true, Allowlist: []string{"local"}}},
+			},
+			wantHook: true,
+		},
+		{
+			name: "allowlisted env",
+			cfg: config.Scheme{
+				Env: "local",
+				Log: config.LogConfig{Redact: config.RedactConfig{Enabled: true, Allowlist: []string{"local"}}},
+			},
+		},
+		{
+			name: "disabled",
+			cfg: config.Scheme{
+				Env: "prod",
+				Log: config.LogConfig{Redact: config.RedactConfig{Enabled: false}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orig := logger.Log().ReplaceHooks(make(logrus.LevelHooks))
+			defer logger.Log().ReplaceHooks(orig)
+
+			app := &App{config: &tt.cfg}
+			if err := app.initLogRedaction(); err != nil {
+				t.Fatalf("initLogRedaction unexpected error: %v", err)
+			}
+
+			hooks := len(logger.Log().Hooks[logrus.InfoLevel])
+			if tt.wantHook && hooks != 1 {
+				t.Fatalf("got %d hooks, want redaction hook registered", hooks)
+			}
+			if !tt.wantHook && hooks != 0 {
+				t.Fatalf("got %d hooks, want none", hooks)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package clock
+
+import (
+	"time"
+)
+
+// Clock is an abstraction over time functions so that
+// time dependent code could be driven deterministically in tests
+type Clock interface {
+	// Now returns the current time
+	Now() time.Time
+	// After waits for the duration to elapse and then sends
+	// the current time on the returned channel
+	After(d time.Duration) <-chan time.Time
+	// NewTicker returns a new Ticker that sends the current
+	// time on its channel after each tick
+	NewTicker(d time.Duration) Ticker
+}
+
+// Ticker is an abstraction over time.Ticker
+type Ticker interface {
+	// C returns the channel on which the ticks are delivered
+	C() <-chan time.Time
+	// Stop turns off the ticker
+	Stop()
+	// Reset stops the ticker and resets its period to the specified duration
+	Reset(d time.Duration)
+}
+
+// New create new Clock instance backed by the standard time package
+func New() Clock {
+	return realClock{}
+}
+
+// realClock is Clock implementation that uses system time
+type realClock struct{}
+
+// Now returns the current system time
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// After is wrapper around time.After
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// NewTicker is wrapper around time.NewTicker
+func (realClock) NewTicker(d time.Duration) Ticker {
+	return &realTicker{time.NewTicker(d)}
+}
+
+// realTicker is Ticker implementation backed by time.Ticker
+type realTicker struct {
+	*time.Ticker
+}
+
+// C returns the underlying ticker channel
+func (t *realTicker) C() <-chan time.Time {
+	return t.Ticker.C
+}
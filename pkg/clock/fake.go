@@ -0,0 +1,218 @@
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Fake is Clock implementation that only moves forward when
+// Advance or Set is called, it is intended for use in tests
+type Fake struct {
+	mu  sync.Mutex
+	now time.Time
+
+	// signalled every time the set of waiters changes
+	changed *sync.Cond
+
+	waiters []*fakeWaiter
+}
+
+// fakeWaiter is a pending After channel or an active ticker
+type fakeWaiter struct {
+	deadline time.Time
+	// period is zero for one-shot waiters
+	period time.Duration
+	ch     chan time.Time
+}
+
+// NewFake create new Fake clock instance set to the given time
+func NewFake(now time.Time) *Fake {
+	f := &Fake{now: now}
+	f.changed = sync.NewCond(&f.mu)
+
+	return f
+}
+
+// Now returns the current fake time
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.now
+}
+
+// After returns a channel that receives the fake time once
+// the clock has been advanced by at least d
+func (f *Fake) After(d time.Duration) <-chan time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	w := &fakeWaiter{deadline: f.now.Add(d), ch: make(chan time.Time, 1)}
+	if d <= 0 {
+		w.ch <- f.now
+		return w.ch
+	}
+
+	f.addLocked(w)
+
+	return w.ch
+}
+
+// NewTicker returns a Ticker that ticks every time the
+// clock has been advanced by the given period
+func (f *Fake) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("clock: non-positive interval for NewTicker")
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	w := &fakeWaiter{deadline: f.now.Add(d), period: d, ch: make(chan time.Time, 1)}
+	f.addLocked(w)
+
+	return &fakeTicker{clock: f, waiter: w}
+}
+
+// Waiters returns the number of pending After
+// channels and active tickers
+func (f *Fake) Waiters() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return len(f.waiters)
+}
+
+// BlockUntil blocks until at least n After channels and tickers
+// are pending, so tests could Advance the clock only after
+// other goroutines have started waiting on it
+func (f *Fake) BlockUntil(n int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for len(f.waiters) < n {
+		f.changed.Wait()
+	}
+}
+
+// Advance moves the clock forward by d and fires all
+// timers and tickers whose deadlines have passed, like
+// Set it is not possible to move the clock backwards
+func (f *Fake) Advance(d time.Duration) {
+	if d < 0 {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.setLocked(f.now.Add(d))
+}
+
+// Set moves the clock to the given time, it is not
+// possible to move the clock backwards
+func (f *Fake) Set(t time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if t.Before(f.now) {
+		return
+	}
+
+	f.setLocked(t)
+}
+
+// setLocked updates current time and notifies expired waiters,
+// caller must hold the lock
+func (f *Fake) setLocked(t time.Time) {
+	f.now = t
+
+	pending := f.waiters[:0]
+	for _, w := range f.waiters {
+		if w.deadline.After(t) {
+			pending = append(pending, w)
+			continue
+		}
+
+		// like time.Ticker the tick is dropped for slow receivers
+		select {
+		case w.ch <- t:
+		default:
+		}
+
+		if w.period > 0 {
+			for !w.deadline.After(t) {
+				w.deadline = w.deadline.Add(w.period)
+			}
+			pending = append(pending, w)
+		}
+	}
+
+	f.waiters = pending
+	f.changed.Broadcast()
+}
+
+// addLocked starts tracking the given waiter,
+// caller must hold the lock
+func (f *Fake) addLocked(w *fakeWaiter) {
+	f.waiters = append(f.waiters, w)
+	f.changed.Broadcast()
+}
+
+// removeLocked stops tracking the given waiter,
+// caller must hold the lock
+func (f *Fake) removeLocked(w *fakeWaiter) {
+	for i, waiter := range f.waiters {
+		if waiter == w {
+			f.waiters = append(f.waiters[:i], f.waiters[i+1:]...)
+			f.changed.Broadcast()
+			return
+		}
+	}
+}
+
+// fakeTicker is Ticker implementation driven by Fake clock
+type fakeTicker struct {
+	clock  *Fake
+	waiter *fakeWaiter
+}
+
+// C returns the ticker channel
+func (t *fakeTicker) C() <-chan time.Time {
+	return t.waiter.ch
+}
+
+// Stop turns off the ticker, like time.Ticker since Go 1.23
+// no stale tick is received after Stop returns
+func (t *fakeTicker) Stop() {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+
+	t.clock.removeLocked(t.waiter)
+	t.drain()
+}
+
+// Reset stops the ticker and resets its period to d,
+// a pending tick is discarded
+func (t *fakeTicker) Reset(d time.Duration) {
+	if d <= 0 {
+		panic("clock: non-positive interval for Ticker.Reset")
+	}
+
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+
+	t.clock.removeLocked(t.waiter)
+	t.drain()
+	t.waiter.period = d
+	t.waiter.deadline = t.clock.now.Add(d)
+	t.clock.addLocked(t.waiter)
+}
+
+// drain discards a pending tick, caller must hold the clock lock
+func (t *fakeTicker) drain() {
+	select {
+	case <-t.waiter.ch:
+	default:
+	}
+}
@@ -0,0 +1,177 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+var epoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// received reports whether a value is ready on ch
+func received(ch <-chan time.Time) (time.Time, bool) {
+	select {
+	case t := <-ch:
+		return t, true
+	default:
+		return time.Time{}, false
+	}
+}
+
+func TestFakeAfter(t *testing.T) {
+	f := NewFake(epoch)
+	ch := f.After(time.Second)
+
+	f.Advance(999 * time.Millisecond)
+	if _, ok := received(ch); ok {
+		t.Fatal("After fired before deadline")
+	}
+
+	f.Advance(time.Millisecond)
+	got, ok := received(ch)
+	if !ok {
+		t.Fatal("After did not fire at deadline")
+	}
+	if want := epoch.Add(time.Second); !got.Equal(want) {
+		t.Fatalf("After sent %v, want %v", got, want)
+	}
+	if n := f.Waiters(); n != 0 {
+		t.Fatalf("Waiters() = %d after firing, want 0", n)
+	}
+}
+
+func TestFakeAfterNonPositive(t *testing.T) {
+	f := NewFake(epoch)
+
+	if _, ok := received(f.After(0)); !ok {
+		t.Fatal("After(0) did not fire immediately")
+	}
+	if n := f.Waiters(); n != 0 {
+		t.Fatalf("Waiters() = %d, want 0", n)
+	}
+}
+
+func TestFakeTickerCatchUp(t *testing.T) {
+	f := NewFake(epoch)
+	tk := f.NewTicker(time.Second)
+	defer tk.Stop()
+
+	// jumping over several periods delivers a single tick
+	// and moves the next deadline past the current time
+	f.Advance(3500 * time.Millisecond)
+	if _, ok := received(tk.C()); !ok {
+		t.Fatal("ticker did not fire")
+	}
+
+	f.Advance(400 * time.Millisecond)
+	if _, ok := received(tk.C()); ok {
+		t.Fatal("ticker fired before next deadline")
+	}
+
+	f.Advance(100 * time.Millisecond)
+	got, ok := received(tk.C())
+	if !ok {
+		t.Fatal("ticker did not fire at next deadline")
+	}
+	if want := epoch.Add(4 * time.Second); !got.Equal(want) {
+		t.Fatalf("ticker sent %v, want %v", got, want)
+	}
+}
+
+func TestFakeTickerStop(t *testing.T) {
+	f := NewFake(epoch)
+	tk := f.NewTicker(time.Second)
+
+	f.Advance(time.Second)
+	tk.Stop()
+
+	if _, ok := received(tk.C()); ok {
+		t.Fatal("stale tick delivered after Stop")
+	}
+
+	f.Advance(time.Second)
+	if _, ok := received(tk.C()); ok {
+		t.Fatal("ticker fired after Stop")
+	}
+	if n := f.Waiters(); n != 0 {
+		t.Fatalf("Waiters() = %d after Stop, want 0", n)
+	}
+}
+
+func TestFakeTickerReset(t *testing.T) {
+	f := NewFake(epoch)
+	tk := f.NewTicker(time.Second)
+	defer tk.Stop()
+
+	f.Advance(time.Second)
+	tk.Reset(3 * time.Second)
+
+	if _, ok := received(tk.C()); ok {
+		t.Fatal("stale tick delivered after Reset")
+	}
+
+	f.Advance(2 * time.Second)
+	if _, ok := received(tk.C()); ok {
+		t.Fatal("ticker fired with old period after Reset")
+	}
+
+	f.Advance(time.Second)
+	if _, ok := received(tk.C()); !ok {
+		t.Fatal("ticker did not fire with new period")
+	}
+}
+
+func TestFakeSetBackwards(t *testing.T) {
+	f := NewFake(epoch)
+	ch := f.After(time.Second)
+
+	f.Set(epoch.Add(-time.Hour))
+	if got := f.Now(); !got.Equal(epoch) {
+		t.Fatalf("Now() = %v after moving backwards, want %v", got, epoch)
+	}
+	if _, ok := received(ch); ok {
+		t.Fatal("After fired when moving backwards")
+	}
+
+	f.Set(epoch.Add(time.Second))
+	if _, ok := received(ch); !ok {
+		t.Fatal("After did not fire when set to deadline")
+	}
+}
+
+func TestFakeAdvanceBackwards(t *testing.T) {
+	f := NewFake(epoch)
+	ch := f.After(time.Second)
+
+	f.Advance(-time.Hour)
+	if got := f.Now(); !got.Equal(epoch) {
+		t.Fatalf("Now() = %v after negative Advance, want %v", got, epoch)
+	}
+
+	f.Advance(time.Second)
+	got, ok := received(ch)
+	if !ok {
+		t.Fatal("After did not fire at deadline")
+	}
+	if want := epoch.Add(time.Second); !got.Equal(want) {
+		t.Fatalf("After sent %v, want %v", got, want)
+	}
+}
+
+func TestFakeBlockUntil(t *testing.T) {
+	f := NewFake(epoch)
+	done := make(chan struct{})
+
+	go func() {
+		<-f.After(time.Second)
+		close(done)
+	}()
+
+	f.BlockUntil(1)
+	f.Advance(time.Second)
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("goroutine was not woken by Advance")
+	}
+}
@@ -0,0 +1,75 @@
+package idgen
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// Generator is an abstraction over UUID generation so that
+// identifiers could be produced deterministically in tests
+type Generator interface {
+	// NewID returns a new unique identifier
+	NewID() (uuid.UUID, error)
+}
+
+// New create new Generator instance for the given UUID version,
+// supported versions are 4 (random) and 7 (time-ordered)
+func New(version int) (Generator, error) {
+	switch version {
+	case 4:
+		return NewV4(), nil
+	case 7:
+		return GeneratorFunc(uuid.NewV7), nil
+	default:
+		return nil, fmt.Errorf("unsupported uuid version %d", version)
+	}
+}
+
+// NewV4 create new Generator instance producing random UUIDv4
+func NewV4() Generator {
+	return GeneratorFunc(uuid.NewRandom)
+}
+
+// GeneratorFunc is an adapter to allow the use of
+// ordinary functions as Generator
+type GeneratorFunc func() (uuid.UUID, error)
+
+// NewID calls f()
+func (f GeneratorFunc) NewID() (uuid.UUID, error) {
+	return f()
+}
+
+// Sequential is Generator implementation that returns
+// predictable identifiers built from an incrementing counter,
+// it is intended for use in tests
+type Sequential struct {
+	mu      sync.Mutex
+	counter uint64
+}
+
+// NewSequential create new Sequential generator instance,
+// the first returned identifier is built from start+1
+func NewSequential(start uint64) *Sequential {
+	return &Sequential{counter: start}
+}
+
+// NewID returns the next identifier in sequence,
+// e.g. 00000000-0000-4000-8000-000000000001
+func (s *Sequential) NewID() (uuid.UUID, error) {
+	s.mu.Lock()
+	s.counter++
+	n := s.counter
+	s.mu.Unlock()
+
+	var id uuid.UUID
+	binary.BigEndian.PutUint64(id[8:], n)
+
+	// keep the identifier valid RFC 4122 version 4 UUID
+	id[6] = (id[6] & 0x0f) | 0x40
+	id[8] = (id[8] & 0x3f) | 0x80
+
+	return id, nil
+}
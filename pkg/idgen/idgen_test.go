@@ -0,0 +1,97 @@
+package idgen
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		version int
+		wantErr bool
+	}{
+		{name: "v4", version: 4},
+		{name: "v7", version: 7},
+		{name: "unsupported", version: 5, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gen, err := New(tt.version)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("New(%d) expected error", tt.version)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("New(%d) unexpected error: %v", tt.version, err)
+			}
+
+			id, err := gen.NewID()
+			if err != nil {
+				t.Fatalf("NewID() unexpected error: %v", err)
+			}
+			if got := int(id.Version()); got != tt.version {
+				t.Fatalf("NewID() version = %d, want %d", got, tt.version)
+			}
+			if id.Variant() != uuid.RFC4122 {
+				t.Fatalf("NewID() variant = %v, want %v", id.Variant(), uuid.RFC4122)
+			}
+		})
+	}
+}
+
+func TestSequential(t *testing.T) {
+	gen := NewSequential(0)
+
+	for _, want := range []string{
+		"00000000-0000-4000-8000-000000000001",
+		"00000000-0000-4000-8000-000000000002",
+	} {
+		id, err := gen.NewID()
+		if err != nil {
+			t.Fatalf("NewID() unexpected error: %v", err)
+		}
+		if id.String() != want {
+			t.Fatalf("NewID() = %s, want %s", id, want)
+		}
+	}
+}
+
+func TestSequentialConcurrent(t *testing.T) {
+	const goroutines, perGoroutine = 8, 100
+
+	gen := NewSequential(0)
+
+	var (
+		mu   sync.Mutex
+		seen = make(map[uuid.UUID]struct{}, goroutines*perGoroutine)
+		wg   sync.WaitGroup
+	)
+
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < perGoroutine; j++ {
+				id, err := gen.NewID()
+				if err != nil {
+					t.Errorf("NewID() unexpected error: %v", err)
+					return
+				}
+				mu.Lock()
+				seen[id] = struct{}{}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(seen) != goroutines*perGoroutine {
+		t.Fatalf("got %d unique ids, want %d", len(seen), goroutines*perGoroutine)
+	}
+}
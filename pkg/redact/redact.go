@@ -0,0 +1,162 @@
+package redact
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Mask is the replacement for redacted values
+const Mask = "[REDACTED]"
+
+// defaultPatterns is a list of PII patterns that are always masked
+var defaultPatterns = []string{
+	// emails
+	`[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}`,
+	// bearer tokens from authorization headers
+	`(?i)bearer\s+[a-zA-Z0-9\-._~+/]+=*`,
+	// JSON Web Tokens
+	`eyJ[a-zA-Z0-9_\-]+\.[a-zA-Z0-9_\-]+\.[a-zA-Z0-9_\-]*`,
+}
+
+// Hook is logrus hook that masks PII in log messages and fields
+type Hook struct {
+	patterns []*regexp.Regexp
+
+	// lowercased names split into segments, fields which keys
+	// contain any of them as whole segments are masked entirely
+	fields [][]string
+}
+
+// NewHook create new Hook instance, given patterns are
+// appended to the default ones, values of fields which keys
+// contain any of given fields names as whole segments
+// (case-insensitive, separated by "_", "-", "." or camelCase)
+// are always masked regardless of their content, e.g. "token"
+// masks "token", "access_token", "accessToken" and "X-Token",
+// but not "max_tokens"
+func NewHook(patterns, fields []string) (*Hook, error) {
+	h := &Hook{
+		fields: make([][]string, 0, len(fields)),
+	}
+
+	for _, p := range append(defaultPatterns, patterns...) {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, fmt.Errorf("compile redaction pattern %q: %w", p, err)
+		}
+		h.patterns = append(h.patterns, re)
+	}
+
+	for _, f := range fields {
+		if segments := splitKey(f); len(segments) > 0 {
+			h.fields = append(h.fields, segments)
+		}
+	}
+
+	return h, nil
+}
+
+// Levels returns all log levels, so every entry is redacted
+func (h *Hook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+// Fire masks PII in entry message and fields, values
+// without PII are left untouched to keep their type
+func (h *Hook) Fire(entry *logrus.Entry) error {
+	entry.Message = h.String(entry.Message)
+
+	for k, v := range entry.Data {
+		if h.isMaskedField(k) {
+			entry.Data[k] = Mask
+			continue
+		}
+
+		var s string
+		switch val := v.(type) {
+		case string:
+			s = val
+		case error, fmt.Stringer:
+			// fmt recovers from panics of nil receivers
+			// the same way the formatter does
+			s = fmt.Sprint(val)
+		default:
+			continue
+		}
+
+		if redacted := h.String(s); redacted != s {
+			entry.Data[k] = redacted
+		}
+	}
+
+	return nil
+}
+
+// isMaskedField reports whether field key contains
+// any of the configured field names as whole segments
+func (h *Hook) isMaskedField(key string) bool {
+	segments := splitKey(key)
+
+	for _, f := range h.fields {
+		for i := 0; i+len(f) <= len(segments); i++ {
+			if slices.Equal(segments[i:i+len(f)], f) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// splitKey splits field key into lowercased segments on
+// "_", "-", "." and camelCase boundaries, e.g.
+// "X-Refresh_tokenHTTPHeader" is split into
+// "x", "refresh", "token", "http", "header"
+func splitKey(key string) []string {
+	var (
+		segments []string
+		current  []rune
+	)
+
+	flush := func() {
+		if len(current) > 0 {
+			segments = append(segments, strings.ToLower(string(current)))
+			current = current[:0]
+		}
+	}
+
+	runes := []rune(key)
+	for i, r := range runes {
+		switch {
+		case r == '_' || r == '-' || r == '.':
+			flush()
+			continue
+		case unicode.IsUpper(r) && i > 0:
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			// "accessToken" and the "Header" part of "HTTPHeader"
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				flush()
+			}
+		}
+
+		current = append(current, r)
+	}
+	flush()
+
+	return segments
+}
+
+// String returns s with all PII patterns masked
+func (h *Hook) String(s string) string {
+	for _, re := range h.patterns {
+		s = re.ReplaceAllString(s, Mask)
+	}
+
+	return s
+}